@@ -3,6 +3,8 @@ package p2p
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"math/rand"
 	"net"
 	"sync"
 	"time"
@@ -21,8 +23,41 @@ import (
 const (
 	bannedPeerKey      = "BannedPeer"
 	defaultBanDuration = time.Hour * 1
+
+	// reconnectAttempts/reconnectInterval control the first phase of
+	// persistent-peer reconnection: a fixed interval retried a bounded
+	// number of times before falling back to backoff.
+	reconnectAttempts = 20
+	reconnectInterval = 5 * time.Second
+
+	// reconnectBackOffAttempts/reconnectBackOffBaseSeconds control the
+	// second phase: reconnectBackOffBaseSeconds**(attempt+1) seconds of
+	// sleep per try (3s, 9s, ... 59049s), which sums to ~24.6h, i.e.
+	// giving up after roughly a day.
+	reconnectBackOffAttempts    = 10
+	reconnectBackOffBaseSeconds = 3
+
+	// ensurePeersPeriod is how often the switch checks whether it still
+	// has enough outbound peers and, if not, dials more from the addrbook.
+	ensurePeersPeriod = 30 * time.Second
+
+	// banPeerScoreThreshold is the trust score (0-100) below which a peer
+	// is banned and disconnected automatically.
+	banPeerScoreThreshold = 10
+
+	// markGoodScoreThreshold is the trust score a peer must have sustained
+	// through its EWMA window before it's biased towards in future dials.
+	// It's deliberately high: a single good event shouldn't be enough, only
+	// a peer that's stayed well-behaved over the tracking window.
+	markGoodScoreThreshold = 90
 )
 
+// dialRandomizerIntervalMilliseconds bounds the random jitter added before
+// every outbound dial. It's a package-level var, not a const, so tests can
+// shrink it to keep dial-heavy tests fast; a value <= 0 skips the jitter
+// entirely instead of panicking in rand.Intn.
+var dialRandomizerIntervalMilliseconds = 3000
+
 //pre-define errors for connecting fail
 var (
 	ErrDuplicatePeer     = errors.New("Duplicate peer")
@@ -30,15 +65,78 @@ var (
 	ErrConnectBannedPeer = errors.New("Connect banned peer")
 )
 
+// ErrTooManyPeers is the reason callers (e.g. the pex reactor, trimming the
+// inbound overflow allowance back down to MaxNumPeers) should pass to
+// StopPeerForError when a peer is being dropped solely to stay under the
+// peer cap, as opposed to a protocol/connection error. Persistent peers are
+// exempt from this specific reason.
+var ErrTooManyPeers = errors.New("too many peers")
+
 // An AddrBook represents an address book from the pex package, which is used to store peer addresses.
 type AddrBook interface {
 	AddAddress(*NetAddress, *NetAddress) error
 	AddOurAddress(*NetAddress)
 	MarkGood(*NetAddress)
+	PickAddress(biasTowardsNewAddrs int) *NetAddress
 	RemoveAddress(*NetAddress)
 	SaveToFile() error
 }
 
+// PeerEventType identifies the kind of lifecycle change a PeerEvent reports.
+type PeerEventType int
+
+// Peer lifecycle event types emitted on the Switch's event bus.
+const (
+	PeerConnected PeerEventType = iota
+	PeerDisconnected
+	PeerBanned
+	PeerHandshakeFailed
+)
+
+func (t PeerEventType) String() string {
+	switch t {
+	case PeerConnected:
+		return "PeerConnected"
+	case PeerDisconnected:
+		return "PeerDisconnected"
+	case PeerBanned:
+		return "PeerBanned"
+	case PeerHandshakeFailed:
+		return "PeerHandshakeFailed"
+	default:
+		return "PeerUnknown"
+	}
+}
+
+// PeerEvent describes a single lifecycle change for a peer, along with the
+// reason it happened (e.g. the error that tore down the connection).
+type PeerEvent struct {
+	Type   PeerEventType
+	Peer   *Peer
+	Addr   *NetAddress
+	Reason interface{}
+}
+
+type peerEventSub struct {
+	ch     chan PeerEvent
+	filter func(PeerEvent) bool
+}
+
+// BanRecord is the persisted reason and expiry for a single ban entry. Each
+// ban is filed under both the peer's pubkey hex and its IP host; bans are
+// only made for handshaken peers, there is no entry point that bans a bare
+// address before a handshake. PeerKey/IP
+// record the sibling keys a single ban was filed under, so UnbanPeer can
+// clear every entry for a peer regardless of which key it's given.
+type BanRecord struct {
+	Reason   string
+	ExpireAt time.Time
+	Level    int
+
+	PeerKey string
+	IP      string
+}
+
 //-----------------------------------------------------------------------------
 
 // Switch handles peer connections and exposes an API to receive incoming messages
@@ -59,9 +157,17 @@ type Switch struct {
 	nodeInfo     *NodeInfo             // our node info
 	nodePrivKey  crypto.PrivKeyEd25519 // our node privkey
 	addrBook     AddrBook
-	bannedPeer   map[string]time.Time
+	bannedPeer   map[string]BanRecord
 	db           dbm.DB
 	mtx          sync.Mutex
+
+	persistentPeersMtx sync.Mutex
+	persistentPeers    map[string]*NetAddress
+
+	trustMetricStore *trust.TrustMetricStore
+
+	eventSubsMtx sync.Mutex
+	eventSubs    []*peerEventSub
 }
 
 // NewSwitch creates a new Switch with the given config.
@@ -77,20 +183,24 @@ func NewSwitch(config *cfg.P2PConfig, addrBook AddrBook, trustHistoryDB dbm.DB)
 		nodeInfo:     nil,
 		addrBook:     addrBook,
 		db:           trustHistoryDB,
+
+		persistentPeers: make(map[string]*NetAddress),
 	}
 	sw.BaseService = *cmn.NewBaseService(nil, "P2P Switch", sw)
-	sw.bannedPeer = make(map[string]time.Time)
+	sw.bannedPeer = make(map[string]BanRecord)
 	if datajson := sw.db.Get([]byte(bannedPeerKey)); datajson != nil {
 		if err := json.Unmarshal(datajson, &sw.bannedPeer); err != nil {
 			return nil
 		}
 	}
 	trust.Init()
+	sw.trustMetricStore = trust.NewTrustMetricStore(trustHistoryDB, trust.DefaultConfig())
 	return sw
 }
 
 // OnStart implements BaseService. It starts all the reactors, peers, and listeners.
 func (sw *Switch) OnStart() error {
+	sw.trustMetricStore.Start()
 	for _, reactor := range sw.reactors {
 		if _, err := reactor.Start(); err != nil {
 			return err
@@ -99,11 +209,13 @@ func (sw *Switch) OnStart() error {
 	for _, listener := range sw.listeners {
 		go sw.listenerRoutine(listener)
 	}
-	return nil
+	go sw.ensurePeersRoutine()
+	return sw.startPersistentPeers()
 }
 
 // OnStop implements BaseService. It stops all listeners, peers, and reactors.
 func (sw *Switch) OnStop() {
+	sw.trustMetricStore.Stop()
 	for _, listener := range sw.listeners {
 		listener.Stop()
 	}
@@ -119,22 +231,108 @@ func (sw *Switch) OnStop() {
 	}
 }
 
-//AddBannedPeer add peer to blacklist
+//AddBannedPeer bans peer for the default duration with no recorded reason.
 func (sw *Switch) AddBannedPeer(peer *Peer) error {
+	return sw.BanPeer(peer, "", defaultBanDuration)
+}
+
+// BanPeer bans peer for duration, recording reason so operators can inspect
+// the ban list over RPC later. The ban is keyed by both the peer's pubkey
+// and its IP host, so it can't be evaded by reconnecting from a new address.
+func (sw *Switch) BanPeer(peer *Peer, reason string, duration time.Duration) error {
+	sw.mtx.Lock()
+	defer sw.mtx.Unlock()
+
+	sw.banKeys(peer.Key, peer.NodeInfo.RemoteAddrHost(), reason, duration)
+	if err := sw.saveBannedPeer(); err != nil {
+		return err
+	}
+	sw.emitPeerEvent(PeerEvent{Type: PeerBanned, Peer: peer, Reason: reason})
+	return nil
+}
+
+// banKeys records the same ban under both peerKey and ip so it can't be
+// evaded by reconnecting under the other identifier. Callers must hold mtx.
+func (sw *Switch) banKeys(peerKey, ip, reason string, duration time.Duration) {
+	record := BanRecord{Reason: reason, ExpireAt: time.Now().Add(duration), PeerKey: peerKey, IP: ip}
+	sw.bannedPeer[peerKey] = record
+	sw.bannedPeer[ip] = record
+}
+
+// UnbanPeer removes key (a pubkey hex or IP host, as returned by BannedPeers)
+// from the ban list, along with the sibling key it was banned alongside, so
+// unbanning by either pubkey or IP clears both.
+func (sw *Switch) UnbanPeer(key string) error {
+	sw.mtx.Lock()
+	defer sw.mtx.Unlock()
+
+	record, ok := sw.bannedPeer[key]
+	if !ok {
+		return nil
+	}
+
+	delete(sw.bannedPeer, key)
+	if record.PeerKey != "" && record.PeerKey != key {
+		delete(sw.bannedPeer, record.PeerKey)
+	}
+	if record.IP != "" && record.IP != key {
+		delete(sw.bannedPeer, record.IP)
+	}
+	return sw.saveBannedPeer()
+}
+
+// BannedPeers returns a snapshot of the current ban list, for RPC callers to
+// inspect and manage at runtime.
+func (sw *Switch) BannedPeers() map[string]BanRecord {
 	sw.mtx.Lock()
 	defer sw.mtx.Unlock()
 
-	key := peer.NodeInfo.RemoteAddrHost()
-	sw.bannedPeer[key] = time.Now().Add(defaultBanDuration)
+	banned := make(map[string]BanRecord, len(sw.bannedPeer))
+	for key, record := range sw.bannedPeer {
+		banned[key] = record
+	}
+	return banned
+}
+
+func (sw *Switch) saveBannedPeer() error {
 	datajson, err := json.Marshal(sw.bannedPeer)
 	if err != nil {
 		return err
 	}
-
 	sw.db.Set([]byte(bannedPeerKey), datajson)
 	return nil
 }
 
+// AddGoodEvent records a positive interaction with peer. Only once its trust
+// score has reached markGoodScoreThreshold - meaning it has stayed
+// well-behaved long enough for the EWMA to reflect it, not just a single
+// good event - is it marked good in the addrbook so future dials prefer it.
+func (sw *Switch) AddGoodEvent(peer *Peer) {
+	tm := sw.trustMetricStore.GetPeerTrustMetric(peer.Key)
+	tm.GoodEvent()
+	if sw.addrBook != nil && peer.outbound && tm.TrustScore() >= markGoodScoreThreshold {
+		sw.addrBook.MarkGood(peer.Addr())
+	}
+}
+
+// AddBadEvent records a negative interaction with peer, weighted by
+// severity. Once the peer's trust score falls below banPeerScoreThreshold,
+// the switch bans and disconnects it automatically.
+func (sw *Switch) AddBadEvent(peer *Peer, weight float64) {
+	tm := sw.trustMetricStore.GetPeerTrustMetric(peer.Key)
+	tm.BadEvent(weight)
+	if tm.TrustScore() >= banPeerScoreThreshold {
+		return
+	}
+
+	reason := "trust metric score below threshold"
+	log.WithFields(log.Fields{"peer": peer, "score": tm.TrustScore()}).Info("banning peer for low trust score")
+	if err := sw.BanPeer(peer, reason, defaultBanDuration); err != nil {
+		log.WithFields(log.Fields{"peer": peer, "err": err}).Error("fail on ban untrusted peer")
+	}
+	sw.StopPeerForError(peer, errors.New(reason))
+}
+
 // AddPeer performs the P2P handshake with a peer
 // that already has a SecretConnection. If all goes well,
 // it starts the peer and adds it to the switch.
@@ -143,10 +341,12 @@ func (sw *Switch) AddBannedPeer(peer *Peer) error {
 func (sw *Switch) AddPeer(pc *peerConn) error {
 	peerNodeInfo, err := pc.HandshakeTimeout(sw.nodeInfo, time.Duration(sw.peerConfig.HandshakeTimeout*time.Second))
 	if err != nil {
+		sw.emitPeerEvent(PeerEvent{Type: PeerHandshakeFailed, Addr: pc.Addr(), Reason: err})
 		return err
 	}
 
 	if err := sw.nodeInfo.CompatibleWith(peerNodeInfo); err != nil {
+		sw.emitPeerEvent(PeerEvent{Type: PeerHandshakeFailed, Addr: pc.Addr(), Reason: err})
 		return err
 	}
 
@@ -161,7 +361,12 @@ func (sw *Switch) AddPeer(pc *peerConn) error {
 			return err
 		}
 	}
-	return sw.peers.Add(peer)
+
+	if err := sw.peers.Add(peer); err != nil {
+		return err
+	}
+	sw.emitPeerEvent(PeerEvent{Type: PeerConnected, Peer: peer})
+	return nil
 }
 
 // AddReactor adds the given reactor to the switch.
@@ -190,9 +395,19 @@ func (sw *Switch) AddListener(l Listener) {
 
 //DialPeerWithAddress dial node from net address
 func (sw *Switch) DialPeerWithAddress(addr *NetAddress) error {
+	// Reserve the address before jittering so a concurrent ensurePeers call
+	// (via IsDialing) sees it as already dialing for the whole jitter window,
+	// not just once the sleep ends.
 	log.Debug("Dialing peer address:", addr)
 	sw.dialing.Set(addr.IP.String(), addr)
 	defer sw.dialing.Delete(addr.IP.String())
+
+	// Jitter the dial so that, after a network blip, nodes don't all try to
+	// reconnect in lockstep and amplify the disruption into a connection storm.
+	if dialRandomizerIntervalMilliseconds > 0 {
+		time.Sleep(time.Duration(rand.Intn(dialRandomizerIntervalMilliseconds)) * time.Millisecond)
+	}
+
 	if err := sw.filterConnByIP(addr.IP.String()); err != nil {
 		return err
 	}
@@ -212,6 +427,125 @@ func (sw *Switch) DialPeerWithAddress(addr *NetAddress) error {
 	return nil
 }
 
+//DialPeersAsync dials a list of peer addresses concurrently. When persistent
+//is true, a failed first dial or a later drop of the outbound connection
+//starts the reconnectToPeer schedule, which gives up after roughly a day.
+func (sw *Switch) DialPeersAsync(addrBook AddrBook, peers []string, persistent bool) error {
+	netAddrs := make([]*NetAddress, 0, len(peers))
+	for _, peer := range peers {
+		netAddr, err := NewNetAddressString(peer)
+		if err != nil {
+			return err
+		}
+		netAddrs = append(netAddrs, netAddr)
+	}
+
+	if addrBook != nil {
+		for _, netAddr := range netAddrs {
+			if err := addrBook.AddAddress(netAddr, netAddr); err != nil {
+				log.WithFields(log.Fields{"addr": netAddr, "err": err}).Error("DialPeersAsync fail on add address to book")
+			}
+		}
+		addrBook.SaveToFile()
+	}
+
+	perm := rand.Perm(len(netAddrs))
+	for _, idx := range perm {
+		netAddr := netAddrs[idx]
+		if persistent {
+			sw.addPersistentPeer(netAddr)
+		}
+		go func(netAddr *NetAddress) {
+			if !persistent {
+				if err := sw.DialPeerWithAddress(netAddr); err != nil {
+					log.WithFields(log.Fields{"addr": netAddr, "err": err}).Error("DialPeersAsync fail on dial peer")
+				}
+				return
+			}
+			sw.persistentPeerSupervisor(netAddr)
+		}(netAddr)
+	}
+	return nil
+}
+
+//DialSeeds dials a shuffled list of seed addresses concurrently, giving node
+//bootstrapping a first-class entry point instead of requiring callers to
+//loop over DialPeerWithAddress themselves.
+func (sw *Switch) DialSeeds(addrBook AddrBook, seeds []string) error {
+	netAddrs := make([]*NetAddress, 0, len(seeds))
+	for _, seed := range seeds {
+		netAddr, err := NewNetAddressString(seed)
+		if err != nil {
+			return err
+		}
+		netAddrs = append(netAddrs, netAddr)
+	}
+
+	perm := rand.Perm(len(netAddrs))
+	for _, idx := range perm {
+		netAddr := netAddrs[idx]
+		go func(netAddr *NetAddress) {
+			if err := sw.DialPeerWithAddress(netAddr); err != nil {
+				log.WithFields(log.Fields{"addr": netAddr, "err": err}).Error("DialSeeds fail on dial seed")
+				return
+			}
+			if addrBook != nil {
+				if err := addrBook.AddAddress(netAddr, netAddr); err != nil {
+					log.WithFields(log.Fields{"addr": netAddr, "err": err}).Error("DialSeeds fail on add seed to book")
+				}
+			}
+		}(netAddr)
+	}
+	return nil
+}
+
+// Subscribe registers a channel that receives peer lifecycle events matching
+// filter. Pass a nil filter to receive every event. The channel is buffered;
+// a subscriber that falls behind drops events rather than blocking the
+// switch. Callers must invoke the returned cancel func once they're done, or
+// the subscription leaks for the life of the process.
+func (sw *Switch) Subscribe(filter func(PeerEvent) bool) (<-chan PeerEvent, func()) {
+	sub := &peerEventSub{ch: make(chan PeerEvent, 32), filter: filter}
+
+	sw.eventSubsMtx.Lock()
+	sw.eventSubs = append(sw.eventSubs, sub)
+	sw.eventSubsMtx.Unlock()
+
+	return sub.ch, func() { sw.Unsubscribe(sub.ch) }
+}
+
+// Unsubscribe removes the subscription backing ch from the event bus and
+// closes ch. It's a no-op if ch was already unsubscribed.
+func (sw *Switch) Unsubscribe(ch <-chan PeerEvent) {
+	sw.eventSubsMtx.Lock()
+	defer sw.eventSubsMtx.Unlock()
+
+	for i, sub := range sw.eventSubs {
+		if sub.ch != ch {
+			continue
+		}
+		sw.eventSubs = append(sw.eventSubs[:i], sw.eventSubs[i+1:]...)
+		close(sub.ch)
+		return
+	}
+}
+
+func (sw *Switch) emitPeerEvent(event PeerEvent) {
+	sw.eventSubsMtx.Lock()
+	defer sw.eventSubsMtx.Unlock()
+
+	for _, sub := range sw.eventSubs {
+		if sub.filter != nil && !sub.filter(event) {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			log.WithFields(log.Fields{"event": event.Type}).Debug("dropping peer event, subscriber channel full")
+		}
+	}
+}
+
 //IsDialing prevent duplicate dialing
 func (sw *Switch) IsDialing(addr *NetAddress) bool {
 	return sw.dialing.Has(addr.IP.String())
@@ -269,8 +603,16 @@ func (sw *Switch) SetNodePrivKey(nodePrivKey crypto.PrivKeyEd25519) {
 	}
 }
 
-// StopPeerForError disconnects from a peer due to external error.
+// StopPeerForError disconnects from a peer due to external error. Persistent
+// peers are never evicted to make room under the peer cap (reason ==
+// ErrTooManyPeers); a genuine protocol/connection error still disconnects
+// them same as any other peer.
 func (sw *Switch) StopPeerForError(peer *Peer, reason interface{}) {
+	if reason == ErrTooManyPeers && sw.isPersistentPeer(peer.Addr()) {
+		log.WithFields(log.Fields{"peer": peer}).Debug("keeping persistent peer over the peer cap")
+		return
+	}
+
 	log.WithFields(log.Fields{"peer": peer, " err": reason}).Debug("stopping peer for error")
 	sw.stopAndRemovePeer(peer, reason)
 }
@@ -305,33 +647,98 @@ func (sw *Switch) addrBookDelSelf() error {
 	return nil
 }
 
-func (sw *Switch) checkBannedPeer(peer string) error {
+// checkBannedPeer looks key (an IP host or pubkey hex) up in the ban list,
+// pruning that entry if its ban has expired.
+func (sw *Switch) checkBannedPeer(key string) error {
 	sw.mtx.Lock()
 	defer sw.mtx.Unlock()
 
-	if banEnd, ok := sw.bannedPeer[peer]; ok {
-		if time.Now().Before(banEnd) {
-			return ErrConnectBannedPeer
+	record, ok := sw.bannedPeer[key]
+	if !ok {
+		return nil
+	}
+	if time.Now().Before(record.ExpireAt) {
+		return ErrConnectBannedPeer
+	}
+
+	delete(sw.bannedPeer, key)
+	return sw.saveBannedPeer()
+}
+
+// ensurePeersRoutine periodically tops up outbound connections from the
+// addrbook so the switch self-heals after peer churn instead of only dialing
+// on explicit request.
+func (sw *Switch) ensurePeersRoutine() {
+	ticker := time.NewTicker(ensurePeersPeriod)
+	defer ticker.Stop()
+
+	sw.ensurePeers()
+	for {
+		select {
+		case <-ticker.C:
+			sw.ensurePeers()
+		case <-sw.Quit():
+			return
 		}
-		sw.delBannedPeer(peer)
 	}
-	return nil
 }
 
-func (sw *Switch) delBannedPeer(addr string) error {
-	sw.mtx.Lock()
-	defer sw.mtx.Unlock()
+func (sw *Switch) ensurePeers() {
+	if sw.addrBook == nil {
+		return
+	}
 
-	delete(sw.bannedPeer, addr)
-	datajson, err := json.Marshal(sw.bannedPeer)
-	if err != nil {
-		return err
+	outbound, _, _ := sw.NumPeers()
+	numToDial := sw.Config.MinNumOutboundPeers - outbound
+	for i := 0; i < numToDial; i++ {
+		addr := sw.randomUnusedAddress()
+		if addr == nil {
+			log.Info("ensurePeers: addrbook exhausted, need more seeds to maintain outbound peers")
+			return
+		}
+
+		go func(addr *NetAddress) {
+			if err := sw.DialPeerWithAddress(addr); err != nil {
+				log.WithFields(log.Fields{"addr": addr, "err": err}).Debug("ensurePeers fail on dial peer")
+			}
+		}(addr)
 	}
+}
 
-	sw.db.Set([]byte(bannedPeerKey), datajson)
+// randomUnusedAddress samples the addrbook for an address we aren't already
+// dialing, connected to, banned, or ourselves.
+func (sw *Switch) randomUnusedAddress() *NetAddress {
+	for attempts := 0; attempts < 10; attempts++ {
+		addr := sw.addrBook.PickAddress(50)
+		if addr == nil {
+			return nil
+		}
+		if sw.IsDialing(addr) {
+			continue
+		}
+		if sw.nodeInfo != nil && addr.String() == sw.nodeInfo.ListenAddr {
+			continue
+		}
+		if sw.checkBannedPeer(addr.IP.String()) != nil {
+			continue
+		}
+		if sw.hasIP(addr.IP.String()) {
+			continue
+		}
+		return addr
+	}
 	return nil
 }
 
+func (sw *Switch) hasIP(ip string) bool {
+	for _, peer := range sw.peers.List() {
+		if peer.RemoteAddrHost() == ip {
+			return true
+		}
+	}
+	return false
+}
+
 func (sw *Switch) filterConnByIP(ip string) error {
 	if ip == sw.nodeInfo.ListenHost() {
 		sw.addrBookDelSelf()
@@ -345,6 +752,10 @@ func (sw *Switch) filterConnByPeer(peer *Peer) error {
 		return err
 	}
 
+	if err := sw.checkBannedPeer(peer.Key); err != nil {
+		return err
+	}
+
 	if sw.nodeInfo.PubKey.Equals(peer.PubKey().Wrap()) {
 		sw.addrBookDelSelf()
 		return ErrConnectSelf
@@ -365,8 +776,9 @@ func (sw *Switch) listenerRoutine(l Listener) {
 
 		// disconnect if we alrady have 2 * MaxNumPeers, we do this because we wanna address book get exchanged even if
 		// the connect is full. The pex will disconnect the peer after address exchange, the max connected peer won't
-		// be double of MaxNumPeers
-		if sw.peers.Size() >= sw.Config.MaxNumPeers*2 {
+		// be double of MaxNumPeers. A configured persistent peer reconnecting inbound is exempt from this cap, same
+		// as it's exempt from the pex trim via StopPeerForError/ErrTooManyPeers.
+		if sw.peers.Size() >= sw.Config.MaxNumPeers*2 && !sw.isPersistentInboundAddr(inConn.RemoteAddr()) {
 			inConn.Close()
 			log.Info("Ignoring inbound connection: already have enough peers.")
 			continue
@@ -396,4 +808,97 @@ func (sw *Switch) stopAndRemovePeer(peer *Peer, reason interface{}) {
 	}
 	sw.peers.Remove(peer)
 	peer.Stop()
+	sw.emitPeerEvent(PeerEvent{Type: PeerDisconnected, Peer: peer, Reason: reason})
+
+	if addr := peer.Addr(); peer.outbound && sw.isPersistentPeer(addr) && sw.checkBannedPeer(addr.IP.String()) == nil {
+		go sw.reconnectToPeer(addr)
+	}
+}
+
+// persistentPeerSupervisor owns the whole retry lifecycle for a configured
+// persistent-peer address: it dials immediately and, if that initial dial
+// fails (e.g. the peer isn't up yet during a coordinated restart), falls
+// straight into the same reconnectToPeer schedule used after a later drop.
+func (sw *Switch) persistentPeerSupervisor(addr *NetAddress) {
+	if sw.checkBannedPeer(addr.IP.String()) != nil {
+		return
+	}
+	if err := sw.DialPeerWithAddress(addr); err != nil {
+		sw.reconnectToPeer(addr)
+	}
+}
+
+func (sw *Switch) addPersistentPeer(addr *NetAddress) {
+	sw.persistentPeersMtx.Lock()
+	defer sw.persistentPeersMtx.Unlock()
+	sw.persistentPeers[addr.String()] = addr
+}
+
+func (sw *Switch) isPersistentPeer(addr *NetAddress) bool {
+	sw.persistentPeersMtx.Lock()
+	defer sw.persistentPeersMtx.Unlock()
+	_, ok := sw.persistentPeers[addr.String()]
+	return ok
+}
+
+// isPersistentInboundAddr reports whether remoteAddr's IP matches a
+// configured persistent peer. Inbound connections arrive from an ephemeral
+// source port, so unlike isPersistentPeer this matches on IP alone rather
+// than the full host:port the peer is configured and dialed under.
+func (sw *Switch) isPersistentInboundAddr(remoteAddr net.Addr) bool {
+	host, _, err := net.SplitHostPort(remoteAddr.String())
+	if err != nil {
+		return false
+	}
+
+	sw.persistentPeersMtx.Lock()
+	defer sw.persistentPeersMtx.Unlock()
+	for _, addr := range sw.persistentPeers {
+		if addr.IP.String() == host {
+			return true
+		}
+	}
+	return false
+}
+
+// reconnectToPeer keeps dialing a persistent peer after it drops: first
+// reconnectAttempts tries at a fixed reconnectInterval, then
+// reconnectBackOffAttempts more with exponential backoff, giving up after
+// roughly a day.
+func (sw *Switch) reconnectToPeer(addr *NetAddress) {
+	start := time.Now()
+	log.WithFields(log.Fields{"addr": addr}).Info("reconnecting to persistent peer")
+
+	for i := 0; i < reconnectAttempts; i++ {
+		if !sw.IsRunning() || sw.checkBannedPeer(addr.IP.String()) != nil {
+			return
+		}
+		if err := sw.DialPeerWithAddress(addr); err == nil {
+			return
+		}
+		time.Sleep(reconnectInterval)
+	}
+
+	log.WithFields(log.Fields{"addr": addr}).Info("falling back to exponential backoff reconnect")
+	for i := 0; i < reconnectBackOffAttempts; i++ {
+		if !sw.IsRunning() || sw.checkBannedPeer(addr.IP.String()) != nil {
+			return
+		}
+		backOffDuration := time.Duration(math.Pow(reconnectBackOffBaseSeconds, float64(i+1))) * time.Second
+		time.Sleep(backOffDuration)
+		if err := sw.DialPeerWithAddress(addr); err == nil {
+			return
+		}
+	}
+	log.WithFields(log.Fields{"addr": addr, "elapsed": time.Since(start)}).Error("failed to reconnect to persistent peer, giving up")
+}
+
+// startPersistentPeers dials every address in Config.PersistentPeers and
+// marks it persistent, so the switch reconnects to it via reconnectToPeer
+// (giving up after roughly a day) whenever it is disconnected.
+func (sw *Switch) startPersistentPeers() error {
+	if len(sw.Config.PersistentPeers) == 0 {
+		return nil
+	}
+	return sw.DialPeersAsync(sw.addrBook, sw.Config.PersistentPeers, true)
 }
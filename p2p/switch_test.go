@@ -0,0 +1,112 @@
+package p2p
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	dbm "github.com/tendermint/tmlibs/db"
+)
+
+// TestReconnectBackOffTotalDuration guards against reconnectBackOffAttempts/
+// reconnectBackOffBaseSeconds drifting away from the "gives up after roughly
+// a day" guarantee documented on the constants and promised to operators.
+func TestReconnectBackOffTotalDuration(t *testing.T) {
+	var total time.Duration
+	for i := 0; i < reconnectBackOffAttempts; i++ {
+		total += time.Duration(math.Pow(reconnectBackOffBaseSeconds, float64(i+1))) * time.Second
+	}
+
+	if total < 20*time.Hour || total > 28*time.Hour {
+		t.Fatalf("expected the backoff phase to span roughly a day, got %s", total)
+	}
+}
+
+// TestUnbanPeerClearsBothKeys ensures unbanning by either the pubkey or the
+// IP a ban was filed under also clears its sibling entry, so a peer can't be
+// left rejected on one identifier after being unbanned on the other.
+func TestUnbanPeerClearsBothKeys(t *testing.T) {
+	sw := &Switch{bannedPeer: make(map[string]BanRecord), db: dbm.NewMemDB()}
+
+	sw.mtx.Lock()
+	sw.banKeys("pubkey1", "1.2.3.4", "misbehaving", time.Hour)
+	sw.mtx.Unlock()
+
+	if err := sw.checkBannedPeer("pubkey1"); err != ErrConnectBannedPeer {
+		t.Fatalf("expected pubkey1 to be banned, got %v", err)
+	}
+	if err := sw.checkBannedPeer("1.2.3.4"); err != ErrConnectBannedPeer {
+		t.Fatalf("expected 1.2.3.4 to be banned, got %v", err)
+	}
+
+	if err := sw.UnbanPeer("pubkey1"); err != nil {
+		t.Fatalf("UnbanPeer: %v", err)
+	}
+
+	if err := sw.checkBannedPeer("pubkey1"); err != nil {
+		t.Fatalf("expected pubkey1 to be unbanned, got %v", err)
+	}
+	if err := sw.checkBannedPeer("1.2.3.4"); err != nil {
+		t.Fatalf("expected 1.2.3.4 to be unbanned after unbanning by pubkey, got %v", err)
+	}
+}
+
+// TestSubscribeUnsubscribe checks that Subscribe delivers events matching
+// its filter, and that the cancel func it returns removes the subscription
+// from eventSubs and closes the channel.
+func TestSubscribeUnsubscribe(t *testing.T) {
+	sw := &Switch{}
+
+	ch, cancel := sw.Subscribe(func(e PeerEvent) bool { return e.Type == PeerBanned })
+	if len(sw.eventSubs) != 1 {
+		t.Fatalf("expected 1 subscriber, got %d", len(sw.eventSubs))
+	}
+
+	sw.emitPeerEvent(PeerEvent{Type: PeerConnected})
+	select {
+	case e := <-ch:
+		t.Fatalf("expected filtered-out event not to be delivered, got %v", e.Type)
+	default:
+	}
+
+	sw.emitPeerEvent(PeerEvent{Type: PeerBanned})
+	select {
+	case e := <-ch:
+		if e.Type != PeerBanned {
+			t.Fatalf("expected PeerBanned, got %v", e.Type)
+		}
+	default:
+		t.Fatal("expected matching event to be delivered")
+	}
+
+	cancel()
+	if len(sw.eventSubs) != 0 {
+		t.Fatalf("expected subscriber to be removed from eventSubs, got %d", len(sw.eventSubs))
+	}
+	if _, ok := <-ch; ok {
+		t.Fatal("expected channel to be closed after the cancel func runs")
+	}
+}
+
+// TestEmitPeerEventDoesNotBlockOnFullSubscriber checks that a subscriber
+// whose buffer has filled can't stall emitPeerEvent for every other
+// subscriber (and caller) on the switch.
+func TestEmitPeerEventDoesNotBlockOnFullSubscriber(t *testing.T) {
+	sw := &Switch{}
+	ch, cancel := sw.Subscribe(nil)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < cap(ch)*2; i++ {
+			sw.emitPeerEvent(PeerEvent{Type: PeerConnected})
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("emitPeerEvent blocked on a full subscriber channel")
+	}
+}